@@ -4,7 +4,6 @@ Use this data source to query SQL Server basic instances
 Example Usage
 
 ```hcl
-
 resource "tencentcloud_sqlserver_basic_instance" "test" {
 	name                = "tf_sqlserver_basic_instance"
 	availability_zone   = var.availability_zone
@@ -28,152 +27,259 @@ package tencentcloud
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	sqlserver "github.com/tencentcloudstack/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
+// sqlserverBasicInstancesPageSize is the default `page_size` used while
+// paging through DescribeSqlserverInstances.
+const sqlserverBasicInstancesPageSize = 100
+
+// SqlserverInstanceFilter collects every optional filter this data source
+// accepts so DescribeSqlserverInstancesByFilter can be called uniformly
+// whether it ends up making one request or several.
+type SqlserverInstanceFilter struct {
+	InstanceId       string
+	ProjectId        int
+	VpcId            string
+	SubnetId         string
+	AvailabilityZone string
+	EngineVersion    string
+	ChargeType       string
+	Status           int
+	Offset           int
+	Limit            int
+}
+
 func dataSourceTencentCloudSqlserverBasicInstances() *schema.Resource {
-	return &schema.Resource{
-		Read: dataSourceTencentCloudSqlserverBasicInstanceRead,
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "ID of the SQL Server basic instance to be query.",
-			},
-			"project_id": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Project ID of the SQL Server basic instance to be query.",
-			},
-			"vpc_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Vpc ID of the SQL Server basic instance to be query.",
-			},
-			"subnet_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Subnet ID of the SQL Server basic instance to be query.",
-			},
-			"result_output_file": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Used to save results.",
-			},
-			"instance_list": {
-				Type:        schema.TypeList,
-				Computed:    true,
-				Description: "A list of SQL Server basic instances. Each element contains the following attributes.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "ID of the SQL Server basic instance.",
-						},
-						"name": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Name of the SQL Server basic instance.",
-						},
-						"charge_type": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Pay type of the SQL Server basic instance. For now, only `POSTPAID_BY_HOUR` is valid.",
-						},
-						"engine_version": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Version of the SQL Server basic database engine. Allowed values are `2008R2`(SQL Server 2008 Enerprise), `2012SP3`(SQL Server 2012 Enterprise), `2016SP1` (SQL Server 2016 Enterprise), `201602`(SQL Server 2016 Standard) and `2017`(SQL Server 2017 Enterprise). Default is `2008R2`.",
-						},
-						"vpc_id": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "ID of VPC.",
-						},
-						"subnet_id": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "ID of subnet.",
-						},
-						"storage": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "Disk size (in GB). Allowed value must be a multiple of 10. The storage must be set with the limit of `storage_min` and `storage_max` which data source `tencentcloud_sqlserver_specinfos` provides.",
-						},
-						"memory": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "Memory size (in GB). Allowed value must be larger than `memory` that data source `tencentcloud_sqlserver_specinfos` provides.",
-						},
-						"cpu": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "The CPU number of the SQL Server basic instance.",
-						},
-						"project_id": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "Project ID, default value is 0.",
-						},
-						"availability_zone": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Availability zone.",
-						},
-						"used_storage": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "Used storage.",
-						},
-						"vip": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "IP for private access.",
-						},
-						"vport": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "Port for private access.",
-						},
-						"create_time": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "Create time of the SQL Server basic instance.",
-						},
-						"status": {
-							Type:        schema.TypeInt,
-							Computed:    true,
-							Description: "Status of the SQL Server basic instance. 1 for applying, 2 for running, 3 for running with limit, 4 for isolated, 5 for recycling, 6 for recycled, 7 for running with task, 8 for off-line, 9 for expanding, 10 for migrating, 11 for readonly, 12 for rebooting.",
-						},
-						"tags": {
-							Type:        schema.TypeMap,
-							Computed:    true,
-							Description: "Tags of the SQL Server basic instance.",
-						},
+	s := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of the SQL Server basic instance to be query.",
+		},
+		"project_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Project ID of the SQL Server basic instance to be query.",
+		},
+		"vpc_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Vpc ID of the SQL Server basic instance to be query.",
+		},
+		"subnet_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Subnet ID of the SQL Server basic instance to be query.",
+		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Availability zone of the SQL Server basic instance to be query.",
+		},
+		"engine_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Version of the SQL Server basic database engine to be query. Allowed values are `2008R2`, `2012SP3`, `2016SP1`, `201602` and `2017`.",
+		},
+		"charge_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Pay type of the SQL Server basic instance to be query. Valid values: `PREPAID`, `POSTPAID_BY_HOUR`.",
+		},
+		"status": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Status of the SQL Server basic instance to be query. 1 for applying, 2 for running, 3 for running with limit, 4 for isolated, 5 for recycling, 6 for recycled, 7 for running with task, 8 for off-line, 9 for expanding, 10 for migrating, 11 for readonly, 12 for rebooting.",
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateStringLengthInRange(1, 100),
+			Description:  "A regex string to filter results by name.",
+		},
+		"tags": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "A map of tags an instance must carry, all of them, to be kept in the results.",
+		},
+		"limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Max number of results to return. When unset, the data source pages through `DescribeSqlserverInstances` until it is exhausted.",
+		},
+		"offset": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     0,
+			Description: "Offset of the first result to return, used together with `limit` to pin a single page instead of paging through every result.",
+		},
+		"page_size": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     sqlserverBasicInstancesPageSize,
+			Description: "Page size used internally for each `DescribeSqlserverInstances` request. When `limit` is set and `name_regex` and `tags` are not, a request never asks for more than the results still missing to reach `limit`.",
+		},
+		"result_output_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Used to save results.",
+		},
+		"instance_list": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of SQL Server basic instances. Each element contains the following attributes.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the SQL Server basic instance.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the SQL Server basic instance.",
+					},
+					"charge_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Pay type of the SQL Server basic instance. Valid values: `PREPAID`, `POSTPAID_BY_HOUR`.",
+					},
+					"engine_version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Version of the SQL Server basic database engine. Allowed values are `2008R2`(SQL Server 2008 Enerprise), `2012SP3`(SQL Server 2012 Enterprise), `2016SP1` (SQL Server 2016 Enterprise), `201602`(SQL Server 2016 Standard) and `2017`(SQL Server 2017 Enterprise). Default is `2008R2`.",
+					},
+					"vpc_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of VPC.",
+					},
+					"subnet_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of subnet.",
+					},
+					"storage": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Disk size (in GB). Allowed value must be a multiple of 10. The storage must be set with the limit of `storage_min` and `storage_max` which data source `tencentcloud_sqlserver_specinfos` provides.",
+					},
+					"memory": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Memory size (in GB). Allowed value must be larger than `memory` that data source `tencentcloud_sqlserver_specinfos` provides.",
+					},
+					"cpu": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The CPU number of the SQL Server basic instance.",
+					},
+					"project_id": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Project ID, default value is 0.",
+					},
+					"availability_zone": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Availability zone.",
+					},
+					"used_storage": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Used storage.",
+					},
+					"vip": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "IP for private access.",
+					},
+					"vport": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Port for private access.",
+					},
+					"create_time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Create time of the SQL Server basic instance.",
+					},
+					"status": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Status of the SQL Server basic instance. 1 for applying, 2 for running, 3 for running with limit, 4 for isolated, 5 for recycling, 6 for recycled, 7 for running with task, 8 for off-line, 9 for expanding, 10 for migrating, 11 for readonly, 12 for rebooting.",
+					},
+					"tags": {
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Description: "Tags of the SQL Server basic instance.",
+					},
+					"internet_service": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Indicates whether the public network access is enabled.",
+					},
+					"public_access_enabled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Indicates whether the instance's public VIP is reachable from the internet.",
+					},
+					"ssl_enforcement": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Indicates whether SSL/TLS is enforced on client connections.",
+					},
+					"user_data_hash": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "SHA256 hash of the user data that was last applied to this instance. This data source does not fill it in and always leaves it empty.",
+					},
+					"last_bootstrap_time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "RFC3339 timestamp of the last successful `bootstrap_sql` run against this instance. This data source does not fill it in and always leaves it empty.",
 					},
 				},
 			},
 		},
 	}
+
+	for k, v := range resultOutputArgsSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceTencentCloudSqlserverBasicInstanceRead,
+		Schema: s,
+	}
 }
 
 func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	defer logElapsed("data_source.tencentcloud_sqlserver_basic_instances.read")()
 
 	var (
-		logId      = getLogId(contextNil)
-		ctx        = context.WithValue(context.TODO(), logIdKey, logId)
-		tcClient   = meta.(*TencentCloudClient).apiV3Conn
-		tagService = &TagService{client: tcClient}
-		service    = SqlserverService{client: tcClient}
-		id         = d.Get("id").(string)
-		projectId  = -1
-		vpcId      string
-		subnetId   string
+		logId            = getLogId(contextNil)
+		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
+		tcClient         = meta.(*TencentCloudClient).apiV3Conn
+		tagService       = &TagService{client: tcClient}
+		service          = SqlserverService{client: tcClient}
+		id               = d.Get("id").(string)
+		projectId        = -1
+		vpcId            string
+		subnetId         string
+		availabilityZone string
+		engineVersion    string
+		chargeType       string
+		status           = -1
+		nameRegex        *regexp.Regexp
 	)
 	if v, ok := d.GetOk("project_id"); ok {
 		projectId = v.(int)
@@ -184,14 +290,103 @@ func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, me
 	if v, ok := d.GetOk("subnet_id"); ok {
 		subnetId = v.(string)
 	}
-	instanceList, err := service.DescribeSqlserverInstances(ctx, id, projectId, vpcId, subnetId, 1)
-	if err != nil {
-		return err
+	if v, ok := d.GetOk("availability_zone"); ok {
+		availabilityZone = v.(string)
+	}
+	if v, ok := d.GetOk("engine_version"); ok {
+		engineVersion = v.(string)
+	}
+	if v, ok := d.GetOk("charge_type"); ok {
+		chargeType = v.(string)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		status = v.(int)
+	}
+	if v, ok := d.GetOk("name_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return fmt.Errorf("name_regex %s is not a valid regular expression: %s", v.(string), err.Error())
+		}
+		nameRegex = r
+	}
+	wantTags := make(map[string]string)
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		wantTags[k] = v.(string)
+	}
+
+	limit, hasLimit := d.GetOk("limit")
+	offset := d.Get("offset").(int)
+	pageSize := d.Get("page_size").(int)
+	if pageSize <= 0 {
+		pageSize = sqlserverBasicInstancesPageSize
+	}
+
+	// name_regex and tags are applied client-side after every instance is
+	// fetched, so limit cannot be pushed down to the API fetch loop when
+	// either is set: doing so would stop paging as soon as `limit` raw
+	// instances were fetched, which can be fewer than `limit` once the
+	// post-fetch filters remove some of them. Page through every matching
+	// instance instead and truncate to `limit` only after filtering.
+	hasPostFilter := nameRegex != nil || len(wantTags) > 0
+
+	var instanceList []*sqlserver.DBInstance
+	for {
+		fetchSize := pageSize
+		if hasLimit && !hasPostFilter && limit.(int)-len(instanceList) < fetchSize {
+			fetchSize = limit.(int) - len(instanceList)
+		}
+		if fetchSize <= 0 {
+			break
+		}
+
+		page, total, err := service.DescribeSqlserverInstancesByFilter(ctx, SqlserverInstanceFilter{
+			InstanceId:       id,
+			ProjectId:        projectId,
+			VpcId:            vpcId,
+			SubnetId:         subnetId,
+			AvailabilityZone: availabilityZone,
+			EngineVersion:    engineVersion,
+			ChargeType:       chargeType,
+			Status:           status,
+			Offset:           offset,
+			Limit:            fetchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		instanceList = append(instanceList, page...)
+		offset += len(page)
+		if len(page) < fetchSize || int64(offset) >= total {
+			break
+		}
 	}
 
 	ids := make([]string, 0, len(instanceList))
-	list := make([]map[string]interface{}, 0, len(instanceList))
+	filtered := make([]*sqlserver.DBInstance, 0, len(instanceList))
 	for _, v := range instanceList {
+		if nameRegex != nil && !nameRegex.MatchString(*v.Name) {
+			continue
+		}
+		filtered = append(filtered, v)
+		ids = append(ids, *v.InstanceId)
+	}
+
+	// Batch tag lookups instead of one DescribeResourceTags call per
+	// instance; the per-instance call does not scale past a handful of
+	// results.
+	tagsByInstance, err := tagService.DescribeResourceTagsByResourceIds(ctx, "sqlserver", "instance", tcClient.Region, ids)
+	if err != nil {
+		return err
+	}
+
+	list := make([]map[string]interface{}, 0, len(filtered))
+	for _, v := range filtered {
+		instanceTags := tagsByInstance[*v.InstanceId]
+		if !sqlserverInstanceMatchesTags(instanceTags, wantTags) {
+			continue
+		}
+
 		listItem := make(map[string]interface{})
 		listItem["id"] = v.InstanceId
 		listItem["name"] = v.Name
@@ -208,20 +403,26 @@ func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, me
 		listItem["used_storage"] = v.UsedStorage
 		listItem["status"] = v.Status
 		listItem["cpu"] = v.Cpu
+		listItem["internet_service"] = v.InternetServiceSupported != nil && *v.InternetServiceSupported == 1
+		listItem["public_access_enabled"] = v.InternetServiceSupported != nil && *v.InternetServiceSupported == 1
+		listItem["ssl_enforcement"] = v.SSLEnable != nil && *v.SSLEnable == 1
+		// user_data_hash and last_bootstrap_time are only known to the
+		// tencentcloud_sqlserver_basic_instance resource that applied them;
+		// DescribeSqlserverInstances has no such field, so they are left
+		// empty here for every instance, managed by that resource or not.
 
 		if *v.PayMode == 1 {
 			listItem["charge_type"] = COMMON_PAYTYPE_PREPAID
 		} else {
 			listItem["charge_type"] = COMMON_PAYTYPE_POSTPAID
 		}
-		tagList, err := tagService.DescribeResourceTags(ctx, "sqlserver", "instance", tcClient.Region, *v.InstanceId)
-		if err != nil {
-			return err
-		}
 
-		listItem["tags"] = tagList
+		listItem["tags"] = instanceTags
 		list = append(list, listItem)
-		ids = append(ids, *v.InstanceId)
+
+		if hasLimit && hasPostFilter && len(list) >= limit.(int) {
+			break
+		}
 	}
 
 	d.SetId(helper.DataResourceIdsHash(ids))
@@ -229,11 +430,16 @@ func dataSourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, me
 		log.Printf("[CRITAL]%s provider set list fail, reason:%s\n", logId, e.Error())
 		return e
 	}
-	output, ok := d.GetOk("result_output_file")
-	if ok && output.(string) != "" {
-		return writeToFile(output.(string), list)
-	}
-
-	return nil
+	return writeResultOutputArtifact(d, "instance_list", list)
+}
 
+// sqlserverInstanceMatchesTags reports whether instanceTags carries every
+// key/value pair in wantTags. An empty wantTags matches everything.
+func sqlserverInstanceMatchesTags(instanceTags map[string]string, wantTags map[string]string) bool {
+	for k, want := range wantTags {
+		if got, ok := instanceTags[k]; !ok || got != want {
+			return false
+		}
+	}
+	return true
 }
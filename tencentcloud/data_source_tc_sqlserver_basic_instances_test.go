@@ -0,0 +1,26 @@
+package tencentcloud
+
+import "testing"
+
+func TestSqlserverInstanceMatchesTags(t *testing.T) {
+	instanceTags := map[string]string{"env": "prod", "owner": "team-a"}
+
+	cases := []struct {
+		name     string
+		wantTags map[string]string
+		want     bool
+	}{
+		{name: "no filter matches everything", wantTags: map[string]string{}, want: true},
+		{name: "matching subset", wantTags: map[string]string{"env": "prod"}, want: true},
+		{name: "value mismatch", wantTags: map[string]string{"env": "staging"}, want: false},
+		{name: "missing key", wantTags: map[string]string{"team": "x"}, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := sqlserverInstanceMatchesTags(instanceTags, c.wantTags); got != c.want {
+				t.Fatalf("sqlserverInstanceMatchesTags() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Use this data source to query the valid (zone, machine_type, cpu, memory,
+storage) combinations that `tencentcloud_sqlserver_basic_instance` accepts,
+so that a bad zone/spec combination fails at plan time instead of during
+Create.
+
+Example Usage
+
+```hcl
+data "tencentcloud_sqlserver_basic_specs" "example" {
+	availability_zone = var.availability_zone
+	engine_version    = "2016SP1"
+	machine_type      = "CLOUD_PREMIUM"
+}
+
+resource "tencentcloud_sqlserver_basic_instance" "example" {
+	for_each          = { for s in data.tencentcloud_sqlserver_basic_specs.example.spec_list : "${s.availability_zone}-${s.cpu}-${s.memory}" => s }
+	name              = "tf-sqlserver-${each.key}"
+	availability_zone = each.value.availability_zone
+	machine_type      = each.value.machine_type
+	cpu               = each.value.cpu
+	memory            = each.value.memory
+	storage           = each.value.storage_min
+	engine_version    = each.value.engine_version
+	vpc_id            = "vpc-26w7r56z"
+	subnet_id         = "subnet-lvlr6eeu"
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func dataSourceTencentCloudSqlserverBasicSpecs() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Availability zone to filter specs by.",
+		},
+		"engine_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Version of the SQL Server database engine to filter specs by. Allowed values are `2008R2`, `2012SP3`, `2016SP1`, `201602` and `2017`.",
+		},
+		"machine_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Host type to filter specs by. Valid values: `CLOUD_PREMIUM`, `CLOUD_SSD`.",
+		},
+		"cpu": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Number of CPU cores to filter specs by.",
+		},
+		"result_output_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Used to save results.",
+		},
+		"spec_list": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of valid SQL Server basic instance specs. Each element contains the following attributes.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"availability_zone": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Availability zone the spec is valid in.",
+					},
+					"machine_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Host type of the spec.",
+					},
+					"engine_version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Version of the SQL Server database engine the spec is valid for.",
+					},
+					"cpu": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Number of CPU cores.",
+					},
+					"memory": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Memory size (in GB).",
+					},
+					"storage_min": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Minimum disk size (in GB) allowed for this spec.",
+					},
+					"storage_max": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Maximum disk size (in GB) allowed for this spec.",
+					},
+				},
+			},
+		},
+	}
+
+	for k, v := range resultOutputArgsSchema() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceTencentCloudSqlserverBasicSpecsRead,
+		Schema: s,
+	}
+}
+
+func dataSourceTencentCloudSqlserverBasicSpecsRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("data_source.tencentcloud_sqlserver_basic_specs.read")()
+
+	var (
+		logId            = getLogId(contextNil)
+		ctx              = context.WithValue(context.TODO(), logIdKey, logId)
+		service          = SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+		availabilityZone string
+		engineVersion    string
+		machineType      string
+		cpu              = -1
+	)
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		availabilityZone = v.(string)
+	}
+	if v, ok := d.GetOk("engine_version"); ok {
+		engineVersion = v.(string)
+	}
+	if v, ok := d.GetOk("machine_type"); ok {
+		machineType = v.(string)
+	}
+	if v, ok := d.GetOk("cpu"); ok {
+		cpu = v.(int)
+	}
+
+	specs, err := service.DescribeSqlserverProductConfig(ctx, availabilityZone, engineVersion, machineType, cpu)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(specs))
+	list := make([]map[string]interface{}, 0, len(specs))
+	for _, spec := range specs {
+		listItem := map[string]interface{}{
+			"availability_zone": spec.Zone,
+			"machine_type":      spec.MachineType,
+			"engine_version":    spec.EngineVersion,
+			"cpu":               spec.Cpu,
+			"memory":            spec.Memory,
+			"storage_min":       spec.StorageMin,
+			"storage_max":       spec.StorageMax,
+		}
+		list = append(list, listItem)
+		ids = append(ids, spec.Zone+"#"+spec.MachineType+"#"+spec.EngineVersion)
+	}
+
+	d.SetId(helper.DataResourceIdsHash(ids))
+	if e := d.Set("spec_list", list); e != nil {
+		log.Printf("[CRITAL]%s provider set spec_list fail, reason:%s\n", logId, e.Error())
+		return e
+	}
+
+	return writeResultOutputArtifact(d, "spec_list", list)
+}
@@ -0,0 +1,475 @@
+/*
+Use this resource to create a SQL Server basic instance.
+
+Example Usage
+
+```hcl
+
+resource "tencentcloud_sqlserver_basic_instance" "test" {
+	name                = "tf_sqlserver_basic_instance"
+	availability_zone   = var.availability_zone
+	charge_type         = "POSTPAID_BY_HOUR"
+	vpc_id              = "vpc-26w7r56z"
+	subnet_id           = "subnet-lvlr6eeu"
+	machine_type        = "CLOUD_PREMIUM"
+	project_id          = 0
+	memory              = 2
+	storage             = 10
+	cpu                 = 1
+	security_groups     = ["sg-nltpbqg1"]
+
+	tags = {
+		"test" = "test"
+	}
+}
+```
+
+Import
+
+SQL Server basic instance can be imported using the id, e.g.
+
+```
+terraform import tencentcloud_sqlserver_basic_instance.foo mssql-3cdq7kx5
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// sqlserverStrictSecurityEnvDefault lets an operator turn strict_security on
+// for every tencentcloud_sqlserver_basic_instance at once by setting
+// TENCENTCLOUD_SQLSERVER_STRICT_SECURITY, instead of having to set it on
+// every resource block and risk forgetting it on the one instance that most
+// needs it. A resource block can still override the environment default
+// either way by setting strict_security explicitly.
+func sqlserverStrictSecurityEnvDefault() (interface{}, error) {
+	raw := os.Getenv("TENCENTCLOUD_SQLSERVER_STRICT_SECURITY")
+	if raw == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(raw)
+}
+
+// SQLSERVER_STATUS_RUNNING is the `status` value DescribeSqlserverInstances
+// reports once an instance has finished applying and is serving traffic.
+const SQLSERVER_STATUS_RUNNING = 2
+
+func resourceTencentCloudSqlserverBasicInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudSqlserverBasicInstanceCreate,
+		Read:   resourceTencentCloudSqlserverBasicInstanceRead,
+		Update: resourceTencentCloudSqlserverBasicInstanceUpdate,
+		Delete: resourceTencentCloudSqlserverBasicInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceTencentCloudSqlserverBasicInstanceStrictSecurityDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the SQL Server basic instance.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Availability zone.",
+			},
+			"charge_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "POSTPAID_BY_HOUR",
+				ForceNew:    true,
+				Description: "Pay type of the SQL Server basic instance. For now, only `POSTPAID_BY_HOUR` is valid.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of VPC.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of subnet.",
+			},
+			"machine_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Host type of the SQL Server basic instance. Valid values: `CLOUD_PREMIUM`, `CLOUD_SSD`.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Project ID, default value is 0.",
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size (in GB). Allowed value must be larger than `memory` that data source `tencentcloud_sqlserver_basic_specs` provides.",
+			},
+			"storage": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Disk size (in GB). Allowed value must be a multiple of 10. The storage must be set with the limit of `storage_min` and `storage_max` which data source `tencentcloud_sqlserver_basic_specs` provides.",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The CPU number of the SQL Server basic instance.",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "2008R2",
+				Description: "Version of the SQL Server basic database engine. Allowed values are `2008R2`, `2012SP3`, `2016SP1`, `201602` and `2017`. Default is `2008R2`.",
+			},
+			"security_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Security group IDs to associate with this instance. Required when `strict_security` is enabled.",
+			},
+			"internet_service": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to enable the public network access. Valid values: `true`, `false`. When `strict_security` is enabled, exposing a public VIP through this flag is refused at plan time.",
+			},
+			"public_access_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether the instance's public VIP is allowed to be reachable from the internet. When `strict_security` is enabled, this must stay `false`.",
+			},
+			"ssl_enforcement": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to force SSL/TLS on client connections. Valid values: `true`, `false`.",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to enable deletion protection on the instance. While `true`, Delete refuses to destroy the instance. Required when `strict_security` is enabled and `charge_type` is `POSTPAID_BY_HOUR`.",
+			},
+			"strict_security": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: sqlserverStrictSecurityEnvDefault,
+				Description: "Indicates whether to enforce this provider's policy-safe defaults on plan. When `true`, a plan that would expose a public VIP, use `POSTPAID_BY_HOUR` without `deletion_protection`, or omit `security_groups` is refused instead of silently applied. Defaults to the `TENCENTCLOUD_SQLSERVER_STRICT_SECURITY` environment variable (or `false` if unset), so it can be turned on for every instance at once instead of being configured per resource block.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Tags of the SQL Server basic instance.",
+			},
+			"user_data": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"user_data_base64", "user_data_file"},
+				Description:   "Plain-text user data run once the instance reaches `status` `2` (running). Mutually exclusive with `user_data_base64` and `user_data_file`.",
+			},
+			"user_data_base64": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"user_data", "user_data_file"},
+				Description:   "Base64-encoded user data run once the instance reaches `status` `2` (running). Mutually exclusive with `user_data` and `user_data_file`.",
+			},
+			"user_data_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"user_data", "user_data_base64"},
+				Description:   "Path to a local file whose contents are run as user data once the instance reaches `status` `2` (running). Mutually exclusive with `user_data` and `user_data_base64`.",
+			},
+			"bootstrap_sql": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of T-SQL statements, or paths to `.sql` script files (any entry ending in `.sql` is read from disk, everything else is sent as-is), executed in order over the private VIP once the instance reaches `status` `2` (running). Drift on this list reruns only the bootstrap, it does not force replacement of the instance.",
+			},
+			"user_data_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 hash of the user data that was last applied to this instance.",
+			},
+			"last_bootstrap_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "RFC3339 timestamp of the last successful `bootstrap_sql` run against this instance.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP for private access.",
+			},
+			"vport": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Port for private access.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the SQL Server basic instance.",
+			},
+			"status": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Status of the SQL Server basic instance. 1 for applying, 2 for running, 3 for running with limit, 4 for isolated, 5 for recycling, 6 for recycled, 7 for running with task, 8 for off-line, 9 for expanding, 10 for migrating, 11 for readonly, 12 for rebooting.",
+			},
+		},
+	}
+}
+
+// resourceTencentCloudSqlserverBasicInstanceStrictSecurityDiff refuses a plan
+// that would violate the guardrails promised by `strict_security`, the
+// equivalent of running a KICS scan before every apply instead of after.
+func resourceTencentCloudSqlserverBasicInstanceStrictSecurityDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("strict_security").(bool) {
+		return nil
+	}
+
+	return validateSqlserverStrictSecurity(
+		diff.Get("internet_service").(bool),
+		diff.Get("public_access_enabled").(bool),
+		diff.Get("charge_type").(string),
+		diff.Get("deletion_protection").(bool),
+		len(diff.Get("security_groups").([]interface{})),
+	)
+}
+
+// validateSqlserverStrictSecurity holds the actual strict_security
+// guardrail checks, factored out of the CustomizeDiff callback so they can
+// be unit tested without constructing a *schema.ResourceDiff.
+func validateSqlserverStrictSecurity(internetService, publicAccessEnabled bool, chargeType string, deletionProtection bool, securityGroupCount int) error {
+	if internetService || publicAccessEnabled {
+		return fmt.Errorf("strict_security is enabled: this instance would expose a public VIP, set internet_service and public_access_enabled to false or disable strict_security")
+	}
+
+	if chargeType == "POSTPAID_BY_HOUR" && !deletionProtection {
+		return fmt.Errorf("strict_security is enabled: charge_type POSTPAID_BY_HOUR requires deletion_protection to be true")
+	}
+
+	if securityGroupCount == 0 {
+		return fmt.Errorf("strict_security is enabled: security_groups must not be empty")
+	}
+
+	return nil
+}
+
+func resourceTencentCloudSqlserverBasicInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instanceId, err := service.CreateSqlserverBasicInstance(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(instanceId)
+
+	if err := waitSqlserverInstanceRunning(ctx, service, instanceId); err != nil {
+		return err
+	}
+
+	extranetEnabled := d.Get("internet_service").(bool) || d.Get("public_access_enabled").(bool)
+	if err := service.ApplySqlserverInstanceCreateSettings(ctx, instanceId, d.Get("tags").(map[string]interface{}), extranetEnabled, d.Get("ssl_enforcement").(bool)); err != nil {
+		return err
+	}
+
+	if err := bootstrapSqlserverBasicInstance(ctx, service, d); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudSqlserverBasicInstanceRead(d, meta)
+}
+
+// waitSqlserverInstanceRunning polls until the instance reaches status 2
+// (running), the precondition for applying user_data/bootstrap_sql over its
+// private VIP.
+func waitSqlserverInstanceRunning(ctx context.Context, service SqlserverService, instanceId string) error {
+	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		instance, has, err := service.DescribeSqlserverInstanceById(ctx, instanceId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if !has {
+			return resource.NonRetryableError(fmt.Errorf("instance %s disappeared while waiting for it to become running", instanceId))
+		}
+		if *instance.Status != SQLSERVER_STATUS_RUNNING {
+			return resource.RetryableError(fmt.Errorf("instance %s is in status %d, waiting for it to become running", instanceId, *instance.Status))
+		}
+		return nil
+	})
+}
+
+// resolveSqlserverUserData returns the effective user data for an instance
+// and its SHA256 hash, so that drift on the source (inline string, base64
+// blob, or file) can be detected without re-reading the raw value every
+// plan.
+func resolveSqlserverUserData(d *schema.ResourceData) (string, string, error) {
+	var userData string
+
+	switch {
+	case d.Get("user_data").(string) != "":
+		userData = d.Get("user_data").(string)
+	case d.Get("user_data_base64").(string) != "":
+		decoded, err := base64.StdEncoding.DecodeString(d.Get("user_data_base64").(string))
+		if err != nil {
+			return "", "", fmt.Errorf("user_data_base64 is not valid base64: %s", err.Error())
+		}
+		userData = string(decoded)
+	case d.Get("user_data_file").(string) != "":
+		content, err := ioutil.ReadFile(d.Get("user_data_file").(string))
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read user_data_file: %s", err.Error())
+		}
+		userData = string(content)
+	}
+
+	sum := sha256.Sum256([]byte(userData))
+	return userData, hex.EncodeToString(sum[:]), nil
+}
+
+// bootstrapSqlserverBasicInstance resolves user_data, runs it over the
+// instance's private VIP, then executes bootstrap_sql in order, reading any
+// entry that ends in `.sql` from disk first. It is a no-op when neither is
+// set, and it only reruns when the resolved hash or the statement list
+// actually changed.
+func bootstrapSqlserverBasicInstance(ctx context.Context, service SqlserverService, d *schema.ResourceData) error {
+	userData, hash, err := resolveSqlserverUserData(d)
+	if err != nil {
+		return err
+	}
+
+	statementsRaw := d.Get("bootstrap_sql").([]interface{})
+	if userData == "" && len(statementsRaw) == 0 {
+		_ = d.Set("user_data_hash", hash)
+		return nil
+	}
+
+	if d.Get("user_data_hash").(string) == hash && !d.HasChange("bootstrap_sql") {
+		return nil
+	}
+
+	statements := make([]string, 0, len(statementsRaw))
+	for _, s := range statementsRaw {
+		statement := s.(string)
+		if strings.HasSuffix(statement, ".sql") {
+			content, err := ioutil.ReadFile(statement)
+			if err != nil {
+				return fmt.Errorf("failed to read bootstrap_sql script file %s: %s", statement, err.Error())
+			}
+			statement = string(content)
+		}
+		statements = append(statements, statement)
+	}
+
+	if err := service.BootstrapSqlserverBasicInstance(ctx, d.Id(), userData, statements); err != nil {
+		return err
+	}
+
+	_ = d.Set("user_data_hash", hash)
+	_ = d.Set("last_bootstrap_time", time.Now().UTC().Format(time.RFC3339))
+	return nil
+}
+
+func resourceTencentCloudSqlserverBasicInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instance, has, err := service.DescribeSqlserverInstanceById(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if !has {
+		d.SetId("")
+		log.Printf("[WARN]%s basic instance %s not found, removing from state\n", logId, d.Id())
+		return nil
+	}
+
+	_ = d.Set("name", instance.Name)
+	_ = d.Set("availability_zone", instance.Zone)
+	_ = d.Set("vpc_id", instance.UniqVpcId)
+	_ = d.Set("subnet_id", instance.UniqSubnetId)
+	_ = d.Set("project_id", instance.ProjectId)
+	_ = d.Set("memory", instance.Memory)
+	_ = d.Set("storage", instance.Storage)
+	_ = d.Set("cpu", instance.Cpu)
+	_ = d.Set("engine_version", instance.Version)
+	_ = d.Set("vip", instance.Vip)
+	_ = d.Set("vport", instance.Vport)
+	_ = d.Set("create_time", instance.CreateTime)
+	_ = d.Set("status", instance.Status)
+
+	if *instance.PayMode == 1 {
+		_ = d.Set("charge_type", COMMON_PAYTYPE_PREPAID)
+	} else {
+		_ = d.Set("charge_type", COMMON_PAYTYPE_POSTPAID)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudSqlserverBasicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := service.ModifySqlserverInstance(ctx, d); err != nil {
+		return err
+	}
+
+	if d.HasChange("user_data") || d.HasChange("user_data_base64") || d.HasChange("user_data_file") || d.HasChange("bootstrap_sql") {
+		if err := bootstrapSqlserverBasicInstance(ctx, service, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudSqlserverBasicInstanceRead(d, meta)
+}
+
+func resourceTencentCloudSqlserverBasicInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("deletion_protection is enabled on instance %s: disable it before destroying this instance", d.Id())
+	}
+
+	return service.DeleteSqlserverInstance(ctx, d.Id())
+}
@@ -0,0 +1,331 @@
+/*
+Use this resource to stamp out a SQL Server basic instance from a
+`tencentcloud_sqlserver_instance_template`.
+
+Every template-backed field on this resource is Computed+Optional: when left
+unset it is resolved from `source_template` when the instance is created,
+but an explicit value, including an explicit zero value like
+`project_id = 0`, always overrides the template. The template is read at
+apply time, not at plan time, so the plan shows inherited fields as known
+only after apply. A value already in state is kept on refresh, so a later
+change to the template is not copied onto existing instances and does not
+force their replacement. `machine_type` stays `ForceNew` like it is on
+`tencentcloud_sqlserver_basic_instance`: TencentCloud does not support
+resizing a running instance's host type in place, so changing it on this
+resource replaces the instance.
+
+Example Usage
+
+```hcl
+
+resource "tencentcloud_sqlserver_instance_template" "example" {
+	name            = "tf-sqlserver-template"
+	engine_version  = "2016SP1"
+	memory          = 4
+	cpu             = 2
+	storage         = 100
+	machine_type    = "CLOUD_PREMIUM"
+	security_groups = ["sg-nltpbqg1"]
+}
+
+resource "tencentcloud_sqlserver_basic_instance_from_template" "example" {
+	name              = "tf-sqlserver-from-template"
+	source_template   = tencentcloud_sqlserver_instance_template.example.id
+	availability_zone = var.availability_zone
+	vpc_id            = "vpc-26w7r56z"
+	subnet_id         = "subnet-lvlr6eeu"
+
+	# overrides the template's storage, everything else is inherited
+	storage = 200
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTencentCloudSqlserverBasicInstanceFromTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudSqlserverBasicInstanceFromTemplateCreate,
+		Read:   resourceTencentCloudSqlserverBasicInstanceFromTemplateRead,
+		Update: resourceTencentCloudSqlserverBasicInstanceFromTemplateUpdate,
+		Delete: resourceTencentCloudSqlserverBasicInstanceFromTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"source_template": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the `tencentcloud_sqlserver_instance_template` this instance is stamped out from. Read when the instance is created, not at plan time; later changes to the template are not copied onto this instance and do not force its replacement.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the SQL Server basic instance.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Availability zone.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of VPC.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of subnet.",
+			},
+			"charge_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "POSTPAID_BY_HOUR",
+				ForceNew:    true,
+				Description: "Pay type of the SQL Server basic instance. For now, only `POSTPAID_BY_HOUR` is valid.",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				Description: "Version of the SQL Server basic database engine. Inherited from `source_template` when unset.",
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Optional:    true,
+				Description: "Memory size (in GB). Inherited from `source_template` when unset.",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Optional:    true,
+				Description: "The CPU number of the SQL Server basic instance. Inherited from `source_template` when unset.",
+			},
+			"storage": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Optional:    true,
+				Description: "Disk size (in GB). Inherited from `source_template` when unset.",
+			},
+			"machine_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Host type of the SQL Server basic instance. Inherited from `source_template` when unset.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Optional:    true,
+				Description: "Project ID. Inherited from `source_template` when unset.",
+			},
+			"security_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Security group IDs. Inherited from `source_template` when unset.",
+			},
+			"backup_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				Description: "Daily backup window. Inherited from `source_template` when unset. Kept in state only; it is not sent to the instance.",
+			},
+			"maintenance_time_span": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				Description: "Weekly maintenance window. Inherited from `source_template` when unset. Kept in state only; it is not sent to the instance.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Optional:    true,
+				Description: "Tags of the SQL Server basic instance. Inherited from `source_template` when unset.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP for private access.",
+			},
+			"vport": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Port for private access.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudSqlserverBasicInstanceFromTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance_from_template.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	template, has, err := service.DescribeSqlserverInstanceTemplateById(ctx, d.Get("source_template").(string))
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("[CRITAL]%s source_template %s does not exist", logId, d.Get("source_template").(string))
+	}
+
+	instanceId, err := service.CreateSqlserverBasicInstanceFromTemplate(ctx, d, template)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(instanceId)
+	setSqlserverTemplateInheritedFields(d, template)
+
+	if err := service.ApplySqlserverInstanceCreateSettings(ctx, instanceId, d.Get("tags").(map[string]interface{}), false, false); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudSqlserverBasicInstanceFromTemplateRead(d, meta)
+}
+
+// setSqlserverTemplateInheritedFields writes the effective value of every
+// template-inheritable field into d: whatever the caller explicitly set on
+// this resource, falling back to the template's default. Without this,
+// fields left unset to inherit from the template would never be written
+// anywhere and would sit at their zero value forever.
+//
+// This uses GetOkExists rather than GetOk: these fields are Computed+Optional
+// with meaningful zero values (project_id = 0, an explicitly empty
+// security_groups/tags), and GetOk can't tell "explicitly set to the zero
+// value" apart from "left unset", which would silently replace the user's
+// override with the template's value on every Read.
+func setSqlserverTemplateInheritedFields(d *schema.ResourceData, template *SqlserverInstanceTemplate) {
+	if v, ok := d.GetOkExists("engine_version"); ok {
+		_ = d.Set("engine_version", v)
+	} else {
+		_ = d.Set("engine_version", template.EngineVersion)
+	}
+	if v, ok := d.GetOkExists("memory"); ok {
+		_ = d.Set("memory", v)
+	} else {
+		_ = d.Set("memory", template.Memory)
+	}
+	if v, ok := d.GetOkExists("cpu"); ok {
+		_ = d.Set("cpu", v)
+	} else {
+		_ = d.Set("cpu", template.Cpu)
+	}
+	if v, ok := d.GetOkExists("storage"); ok {
+		_ = d.Set("storage", v)
+	} else {
+		_ = d.Set("storage", template.Storage)
+	}
+	if v, ok := d.GetOkExists("machine_type"); ok {
+		_ = d.Set("machine_type", v)
+	} else {
+		_ = d.Set("machine_type", template.MachineType)
+	}
+	if v, ok := d.GetOkExists("project_id"); ok {
+		_ = d.Set("project_id", v)
+	} else {
+		_ = d.Set("project_id", template.ProjectId)
+	}
+	if v, ok := d.GetOkExists("security_groups"); ok {
+		_ = d.Set("security_groups", v)
+	} else {
+		_ = d.Set("security_groups", template.SecurityGroups)
+	}
+	if v, ok := d.GetOkExists("backup_time"); ok {
+		_ = d.Set("backup_time", v)
+	} else {
+		_ = d.Set("backup_time", template.BackupTime)
+	}
+	if v, ok := d.GetOkExists("maintenance_time_span"); ok {
+		_ = d.Set("maintenance_time_span", v)
+	} else {
+		_ = d.Set("maintenance_time_span", template.MaintenanceTimeSpan)
+	}
+	if v, ok := d.GetOkExists("tags"); ok {
+		_ = d.Set("tags", v)
+	} else {
+		_ = d.Set("tags", template.Tags)
+	}
+}
+
+func resourceTencentCloudSqlserverBasicInstanceFromTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance_from_template.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	instance, has, err := service.DescribeSqlserverInstanceById(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if !has {
+		d.SetId("")
+		log.Printf("[WARN]%s basic instance %s not found, removing from state\n", logId, d.Id())
+		return nil
+	}
+
+	// The instance itself is the source of truth for anything it reports;
+	// DescribeSqlserverInstanceById does not expose machine_type,
+	// security_groups, backup_time, maintenance_time_span or tags, so those
+	// are re-resolved from the template below instead of being left unset.
+	_ = d.Set("name", instance.Name)
+	_ = d.Set("engine_version", instance.Version)
+	_ = d.Set("memory", instance.Memory)
+	_ = d.Set("cpu", instance.Cpu)
+	_ = d.Set("storage", instance.Storage)
+	_ = d.Set("project_id", instance.ProjectId)
+	_ = d.Set("vip", instance.Vip)
+	_ = d.Set("vport", instance.Vport)
+
+	template, has, err := service.DescribeSqlserverInstanceTemplateById(ctx, d.Get("source_template").(string))
+	if err != nil {
+		return err
+	}
+	if has {
+		setSqlserverTemplateInheritedFields(d, template)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudSqlserverBasicInstanceFromTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance_from_template.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := service.ModifySqlserverInstance(ctx, d); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudSqlserverBasicInstanceFromTemplateRead(d, meta)
+}
+
+func resourceTencentCloudSqlserverBasicInstanceFromTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_basic_instance_from_template.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	return service.DeleteSqlserverInstance(ctx, d.Id())
+}
@@ -0,0 +1,61 @@
+package tencentcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestSetSqlserverTemplateInheritedFields(t *testing.T) {
+	template := &SqlserverInstanceTemplate{
+		EngineVersion:       "2016SP1",
+		Memory:              4,
+		Cpu:                 2,
+		Storage:             100,
+		MachineType:         "CLOUD_PREMIUM",
+		ProjectId:           5,
+		SecurityGroups:      []string{"sg-template"},
+		BackupTime:          "02:00-06:00",
+		MaintenanceTimeSpan: "Mon 02:00-06:00",
+		Tags:                map[string]string{"owner": "template"},
+	}
+
+	resourceSchema := resourceTencentCloudSqlserverBasicInstanceFromTemplate().Schema
+
+	t.Run("unset fields inherit from the template", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+
+		setSqlserverTemplateInheritedFields(d, template)
+
+		if got := d.Get("project_id").(int); got != 5 {
+			t.Fatalf("project_id = %d, want inherited 5", got)
+		}
+		if got := d.Get("machine_type").(string); got != "CLOUD_PREMIUM" {
+			t.Fatalf("machine_type = %q, want inherited CLOUD_PREMIUM", got)
+		}
+	})
+
+	t.Run("an explicit zero value overrides the template instead of being treated as unset", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+			"project_id": 0,
+		})
+
+		setSqlserverTemplateInheritedFields(d, template)
+
+		if got := d.Get("project_id").(int); got != 0 {
+			t.Fatalf("project_id = %d, want explicit override 0, not the template's %d", got, template.ProjectId)
+		}
+	})
+
+	t.Run("an explicit override still wins over the template", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+			"storage": 200,
+		})
+
+		setSqlserverTemplateInheritedFields(d, template)
+
+		if got := d.Get("storage").(int); got != 200 {
+			t.Fatalf("storage = %d, want explicit override 200", got)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package tencentcloud
+
+import "testing"
+
+func TestValidateSqlserverStrictSecurity(t *testing.T) {
+	cases := []struct {
+		name                string
+		internetService     bool
+		publicAccessEnabled bool
+		chargeType          string
+		deletionProtection  bool
+		securityGroupCount  int
+		wantErr             bool
+	}{
+		{
+			name:               "compliant prepaid instance",
+			chargeType:         "PREPAID",
+			securityGroupCount: 1,
+			wantErr:            false,
+		},
+		{
+			name:               "compliant hourly instance with deletion protection",
+			chargeType:         "POSTPAID_BY_HOUR",
+			deletionProtection: true,
+			securityGroupCount: 1,
+			wantErr:            false,
+		},
+		{
+			name:               "internet_service exposes a public VIP",
+			internetService:    true,
+			chargeType:         "PREPAID",
+			securityGroupCount: 1,
+			wantErr:            true,
+		},
+		{
+			name:                "public_access_enabled exposes a public VIP",
+			publicAccessEnabled: true,
+			chargeType:          "PREPAID",
+			securityGroupCount:  1,
+			wantErr:             true,
+		},
+		{
+			name:               "hourly without deletion protection is refused",
+			chargeType:         "POSTPAID_BY_HOUR",
+			deletionProtection: false,
+			securityGroupCount: 1,
+			wantErr:            true,
+		},
+		{
+			name:               "empty security_groups is refused",
+			chargeType:         "PREPAID",
+			securityGroupCount: 0,
+			wantErr:            true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateSqlserverStrictSecurity(c.internetService, c.publicAccessEnabled, c.chargeType, c.deletionProtection, c.securityGroupCount)
+			if c.wantErr && err == nil {
+				t.Fatalf("expected an error, got nil")
+			}
+			if !c.wantErr && err != nil {
+				t.Fatalf("expected no error, got %s", err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Use this resource to create a reusable SQL Server basic instance template.
+
+A template does not provision any resource by itself; it is only read by
+`tencentcloud_sqlserver_basic_instance_from_template` at plan time so that
+many instances can be stamped out from a single set of defaults.
+
+Example Usage
+
+```hcl
+
+resource "tencentcloud_sqlserver_instance_template" "example" {
+	name              = "tf-sqlserver-template"
+	engine_version    = "2016SP1"
+	memory            = 4
+	cpu               = 2
+	storage           = 100
+	machine_type      = "CLOUD_PREMIUM"
+	project_id        = 0
+	security_groups   = ["sg-nltpbqg1"]
+
+	tags = {
+		"createdBy" = "terraform"
+	}
+}
+```
+*/
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTencentCloudSqlserverInstanceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudSqlserverInstanceTemplateCreate,
+		Read:   resourceTencentCloudSqlserverInstanceTemplateRead,
+		Update: resourceTencentCloudSqlserverInstanceTemplateUpdate,
+		Delete: resourceTencentCloudSqlserverInstanceTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the SQL Server instance template.",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "2008R2",
+				Description: "Default version of the SQL Server database engine stamped out instances will use unless overridden. Allowed values are `2008R2`, `2012SP3`, `2016SP1`, `201602` and `2017`.",
+			},
+			"memory": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Default memory size (in GB) for instances created from this template.",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Default CPU core count for instances created from this template.",
+			},
+			"storage": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Default disk size (in GB) for instances created from this template. Must be a multiple of 10.",
+			},
+			"machine_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Default host type for instances created from this template. Valid values: `CLOUD_PREMIUM`, `CLOUD_SSD`.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Default project ID for instances created from this template.",
+			},
+			"security_groups": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Default security group IDs for instances created from this template.",
+			},
+			"backup_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Default daily backup window, e.g. `02:00-06:00`, for instances created from this template.",
+			},
+			"maintenance_time_span": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Default weekly maintenance window, e.g. `Mon 02:00-06:00`, for instances created from this template.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Default tags applied to instances created from this template.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Create time of the SQL Server instance template.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudSqlserverInstanceTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_instance_template.create")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	templateId, err := service.CreateSqlserverInstanceTemplate(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(templateId)
+	return resourceTencentCloudSqlserverInstanceTemplateRead(d, meta)
+}
+
+func resourceTencentCloudSqlserverInstanceTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_instance_template.read")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	template, has, err := service.DescribeSqlserverInstanceTemplateById(ctx, d.Id())
+	if err != nil {
+		return err
+	}
+	if !has {
+		d.SetId("")
+		log.Printf("[WARN]%s instance template %s not found, removing from state\n", logId, d.Id())
+		return nil
+	}
+
+	_ = d.Set("name", template.Name)
+	_ = d.Set("engine_version", template.EngineVersion)
+	_ = d.Set("memory", template.Memory)
+	_ = d.Set("cpu", template.Cpu)
+	_ = d.Set("storage", template.Storage)
+	_ = d.Set("machine_type", template.MachineType)
+	_ = d.Set("project_id", template.ProjectId)
+	_ = d.Set("security_groups", template.SecurityGroups)
+	_ = d.Set("backup_time", template.BackupTime)
+	_ = d.Set("maintenance_time_span", template.MaintenanceTimeSpan)
+	_ = d.Set("tags", template.Tags)
+	_ = d.Set("create_time", template.CreateTime)
+
+	return nil
+}
+
+func resourceTencentCloudSqlserverInstanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_instance_template.update")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	if err := service.ModifySqlserverInstanceTemplate(ctx, d); err != nil {
+		return err
+	}
+
+	return resourceTencentCloudSqlserverInstanceTemplateRead(d, meta)
+}
+
+func resourceTencentCloudSqlserverInstanceTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	defer logElapsed("resource.tencentcloud_sqlserver_instance_template.delete")()
+
+	logId := getLogId(contextNil)
+	ctx := context.WithValue(context.TODO(), logIdKey, logId)
+	service := SqlserverService{client: meta.(*TencentCloudClient).apiV3Conn}
+
+	return service.DeleteSqlserverInstanceTemplateById(ctx, d.Id())
+}
@@ -0,0 +1,208 @@
+/*
+Shared helpers for data sources that expose a `result_output_format`
+alongside the legacy `result_output_file`, so downstream tooling can consume
+a stable, versioned document instead of whatever `writeToFile` happened to
+serialize.
+*/
+package tencentcloud
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+// resultOutputSchemaVersion is the current schema_version stamped onto the
+// envelope written by writeResultOutputArtifact.
+const resultOutputSchemaVersion = 1
+
+// resultOutputArgsSchema returns the `result_output_format`,
+// `result_output_schema_version` and `result_output_fields` arguments a data
+// source embeds next to its own `result_output_file`.
+func resultOutputArgsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"result_output_format": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Format used to serialize `result_output_file`. Valid values: `json`, `json_pretty`, `yaml`, `csv`, `hcl`. Defaults to the legacy raw dump when unset.",
+		},
+		"result_output_schema_version": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     resultOutputSchemaVersion,
+			Description: "Schema version to stamp onto the `result_output_file` envelope. The value is written as given; the shape of the envelope does not depend on it.",
+		},
+		"result_output_fields": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Allow-list of fields to keep on each result when writing `result_output_file`. All fields are kept when unset.",
+		},
+	}
+}
+
+// resultOutputEnvelope is the `{"schema_version": N, "generated_at": ...}`
+// wrapper the json, json_pretty, yaml and hcl formats of
+// `result_output_file` are written inside. The csv format holds the rows
+// only, without schema_version or generated_at.
+type resultOutputEnvelope struct {
+	SchemaVersion int                      `json:"schema_version" yaml:"schema_version"`
+	GeneratedAt   string                   `json:"generated_at" yaml:"generated_at"`
+	Items         []map[string]interface{} `json:"instances" yaml:"instances"`
+}
+
+// writeResultOutputArtifact writes list to the file named by
+// result_output_file in d, in the format named by result_output_format. The
+// csv and hcl formats are written to disk one item at a time; the json,
+// json_pretty and yaml formats encode the whole document in memory before
+// writing it. When result_output_format is unset it
+// falls back to the legacy writeToFile behavior so existing configurations
+// keep working unchanged.
+func writeResultOutputArtifact(d *schema.ResourceData, itemsKey string, list []map[string]interface{}) error {
+	output, ok := d.GetOk("result_output_file")
+	if !ok || output.(string) == "" {
+		return nil
+	}
+
+	format, _ := d.GetOk("result_output_format")
+	if format == nil || format.(string) == "" {
+		return writeToFile(output.(string), list)
+	}
+
+	list = applyResultOutputFieldAllowList(d, list)
+
+	file, err := os.Create(output.(string))
+	if err != nil {
+		return fmt.Errorf("failed to create result_output_file %s: %s", output.(string), err.Error())
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	defer writer.Flush()
+
+	envelope := resultOutputEnvelope{
+		SchemaVersion: d.Get("result_output_schema_version").(int),
+		GeneratedAt:   time.Now().UTC().Format(time.RFC3339),
+		Items:         list,
+	}
+
+	switch format.(string) {
+	case "json":
+		return json.NewEncoder(writer).Encode(envelope)
+	case "json_pretty":
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(envelope)
+	case "yaml":
+		encoded, err := yaml.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(encoded)
+		return err
+	case "csv":
+		return writeResultOutputCSV(writer, list)
+	case "hcl":
+		return writeResultOutputHCL(writer, itemsKey, envelope)
+	default:
+		return fmt.Errorf("result_output_format must be one of `json`, `json_pretty`, `yaml`, `csv`, `hcl`, got %q", format.(string))
+	}
+}
+
+// applyResultOutputFieldAllowList drops every field not named in
+// result_output_fields, leaving list untouched when the allow-list is empty.
+func applyResultOutputFieldAllowList(d *schema.ResourceData, list []map[string]interface{}) []map[string]interface{} {
+	fieldsRaw := d.Get("result_output_fields").([]interface{})
+	if len(fieldsRaw) == 0 {
+		return list
+	}
+
+	fields := make([]string, 0, len(fieldsRaw))
+	for _, f := range fieldsRaw {
+		fields = append(fields, f.(string))
+	}
+
+	filtered := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		kept := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			if v, ok := item[f]; ok {
+				kept[f] = v
+			}
+		}
+		filtered = append(filtered, kept)
+	}
+
+	return filtered
+}
+
+// writeResultOutputCSV streams rows to writer one at a time instead of
+// buffering the whole table, using the union of keys across list (sorted)
+// as the header.
+func writeResultOutputCSV(writer *bufio.Writer, list []map[string]interface{}) error {
+	fieldSet := make(map[string]struct{})
+	for _, item := range list {
+		for k := range item {
+			fieldSet[k] = struct{}{}
+		}
+	}
+	fields := make([]string, 0, len(fieldSet))
+	for k := range fieldSet {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(fields); err != nil {
+		return err
+	}
+	for _, item := range list {
+		row := make([]string, len(fields))
+		for i, f := range fields {
+			row[i] = fmt.Sprintf("%v", item[f])
+		}
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// writeResultOutputHCL renders the envelope as a sequence of itemsKey blocks
+// so the artifact can be reviewed, or even re-used as example HCL, without a
+// JSON/YAML decoder.
+func writeResultOutputHCL(writer *bufio.Writer, itemsKey string, envelope resultOutputEnvelope) error {
+	if _, err := fmt.Fprintf(writer, "schema_version = %d\ngenerated_at   = %q\n\n", envelope.SchemaVersion, envelope.GeneratedAt); err != nil {
+		return err
+	}
+
+	for _, item := range envelope.Items {
+		fields := make([]string, 0, len(item))
+		for k := range item {
+			fields = append(fields, k)
+		}
+		sort.Strings(fields)
+
+		if _, err := fmt.Fprintf(writer, "%s {\n", itemsKey); err != nil {
+			return err
+		}
+		for _, f := range fields {
+			if _, err := fmt.Fprintf(writer, "  %s = %q\n", f, fmt.Sprintf("%v", item[f])); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprint(writer, "}\n\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,883 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	sqlserver "github.com/tencentcloudstack/tencentcloud-sdk-go/tencentcloud/sqlserver/v20180328"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+// SqlserverService wraps the SQL Server v20180328 client every
+// tencentcloud_sqlserver_* resource and data source goes through, the same
+// way every other *Service type in this provider wraps its client.
+type SqlserverService struct {
+	client *connectivity.TencentCloudClient
+}
+
+// SqlserverProductSpec is one valid (zone, machine_type, engine_version, cpu,
+// memory, storage range) combination `tencentcloud_sqlserver_basic_specs`
+// surfaces, flattened out of DescribeProductConfig's per-zone spec lists.
+type SqlserverProductSpec struct {
+	Zone          string
+	MachineType   string
+	EngineVersion string
+	Cpu           int
+	Memory        int
+	StorageMin    int
+	StorageMax    int
+}
+
+// DescribeSqlserverProductConfig returns every SqlserverProductSpec matching
+// the given filters; an empty string filter or a negative cpu is not
+// applied.
+func (me *SqlserverService) DescribeSqlserverProductConfig(ctx context.Context, availabilityZone, engineVersion, machineType string, cpu int) ([]*SqlserverProductSpec, error) {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewDescribeProductConfigRequest()
+	if availabilityZone != "" {
+		request.Zone = &availabilityZone
+	}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().DescribeProductConfig(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return nil, err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	specs := make([]*SqlserverProductSpec, 0)
+	for _, zoneConfig := range response.Response.SpecInfoList {
+		zone := ""
+		if zoneConfig.Zone != nil {
+			zone = *zoneConfig.Zone
+		}
+
+		for _, spec := range zoneConfig.SpecInfoList {
+			s := &SqlserverProductSpec{Zone: zone}
+			if spec.MachineType != nil {
+				s.MachineType = *spec.MachineType
+			}
+			if spec.Version != nil {
+				s.EngineVersion = *spec.Version
+			}
+			if spec.Cpu != nil {
+				s.Cpu = int(*spec.Cpu)
+			}
+			if spec.Memory != nil {
+				s.Memory = int(*spec.Memory)
+			}
+			if spec.MinStorageSize != nil {
+				s.StorageMin = int(*spec.MinStorageSize)
+			}
+			if spec.MaxStorageSize != nil {
+				s.StorageMax = int(*spec.MaxStorageSize)
+			}
+
+			if engineVersion != "" && s.EngineVersion != engineVersion {
+				continue
+			}
+			if machineType != "" && s.MachineType != machineType {
+				continue
+			}
+			if cpu >= 0 && s.Cpu != cpu {
+				continue
+			}
+
+			specs = append(specs, s)
+		}
+	}
+
+	return specs, nil
+}
+
+// CreateSqlserverBasicInstance creates a SQL Server basic instance and
+// returns its instance id once the create order has been fulfilled.
+func (me *SqlserverService) CreateSqlserverBasicInstance(ctx context.Context, d *schema.ResourceData) (string, error) {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewCreateBasicDBInstancesRequest()
+	zone := d.Get("availability_zone").(string)
+	vpcId := d.Get("vpc_id").(string)
+	subnetId := d.Get("subnet_id").(string)
+	machineType := d.Get("machine_type").(string)
+	projectId := int64(d.Get("project_id").(int))
+	memory := uint64(d.Get("memory").(int))
+	storage := uint64(d.Get("storage").(int))
+	engineVersion := d.Get("engine_version").(string)
+	goodsNum := int64(1)
+
+	request.Zone = &zone
+	request.UniqVpcId = &vpcId
+	request.UniqSubnetId = &subnetId
+	request.MachineType = &machineType
+	request.ProjectId = &projectId
+	request.Memory = &memory
+	request.Storage = &storage
+	request.DBVersion = &engineVersion
+	request.GoodsNum = &goodsNum
+
+	if cpu, ok := d.GetOk("cpu"); ok && cpu.(int) > 0 {
+		cpuValue := int64(cpu.(int))
+		request.Cpu = &cpuValue
+	}
+
+	for _, v := range d.Get("security_groups").([]interface{}) {
+		sgId := v.(string)
+		request.SecurityGroupList = append(request.SecurityGroupList, &sgId)
+	}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().CreateBasicDBInstances(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return "", err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if len(response.Response.DealNames) == 0 {
+		return "", fmt.Errorf("[CRITAL]%s CreateBasicDBInstances returned no deal name", logId)
+	}
+	dealName := *response.Response.DealNames[0]
+
+	var instanceId string
+	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		id, isReady, inErr := me.DescribeSqlserverInstanceIdByDealName(ctx, dealName)
+		if inErr != nil {
+			return resource.NonRetryableError(inErr)
+		}
+		if !isReady {
+			return resource.RetryableError(fmt.Errorf("deal %s has not produced an instance id yet", dealName))
+		}
+		instanceId = id
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		if err := me.renameSqlserverBasicInstance(ctx, instanceId, name.(string)); err != nil {
+			return instanceId, err
+		}
+	}
+
+	return instanceId, nil
+}
+
+// DescribeSqlserverInstanceIdByDealName resolves the instance id a
+// CreateBasicDBInstances deal produced. isReady is false while the order is
+// still being fulfilled.
+func (me *SqlserverService) DescribeSqlserverInstanceIdByDealName(ctx context.Context, dealName string) (instanceId string, isReady bool, errRet error) {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewDescribeOrdersRequest()
+	request.DealName = &dealName
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().DescribeOrders(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return "", false, err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if len(response.Response.Deals) == 0 || len(response.Response.Deals[0].InstanceIds) == 0 {
+		return "", false, nil
+	}
+
+	return *response.Response.Deals[0].InstanceIds[0], true, nil
+}
+
+func (me *SqlserverService) renameSqlserverBasicInstance(ctx context.Context, instanceId, name string) error {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewModifyDBInstanceNameRequest()
+	request.InstanceId = &instanceId
+	request.InstanceName = &name
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().ModifyDBInstanceName(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return nil
+}
+
+// DescribeSqlserverInstanceById returns the SQL Server instance with the
+// given id, has is false when it no longer exists.
+func (me *SqlserverService) DescribeSqlserverInstanceById(ctx context.Context, instanceId string) (instance *sqlserver.DBInstance, has bool, errRet error) {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewDescribeDBInstancesRequest()
+	request.InstanceIdSet = []*string{&instanceId}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return nil, false, err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if len(response.Response.DBInstances) == 0 {
+		return nil, false, nil
+	}
+
+	return response.Response.DBInstances[0], true, nil
+}
+
+// ModifySqlserverInstance pushes name, memory/storage/cpu, project_id,
+// internet_service/public_access_enabled, ssl_enforcement and tags to the
+// instance when they changed in d. Fields that are ForceNew on the calling
+// resource never reach here because Terraform replaces the instance instead
+// of calling Update for them. Changes to any other field, such as
+// security_groups, or engine_version on
+// tencentcloud_sqlserver_basic_instance_from_template where it is not
+// ForceNew, are not sent to the instance.
+func (me *SqlserverService) ModifySqlserverInstance(ctx context.Context, d *schema.ResourceData) error {
+	logId := getLogId(ctx)
+	instanceId := d.Id()
+
+	if d.HasChange("name") {
+		if err := me.renameSqlserverBasicInstance(ctx, instanceId, d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("memory") || d.HasChange("storage") || d.HasChange("cpu") {
+		request := sqlserver.NewUpgradeDBInstanceRequest()
+		memory := uint64(d.Get("memory").(int))
+		storage := uint64(d.Get("storage").(int))
+		request.InstanceId = &instanceId
+		request.Memory = &memory
+		request.Storage = &storage
+		if cpu, ok := d.GetOk("cpu"); ok && cpu.(int) > 0 {
+			cpuValue := int64(cpu.(int))
+			request.Cpu = &cpuValue
+		}
+
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseSqlserverClient().UpgradeDBInstance(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return err
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+		if err := me.waitSqlserverInstanceTaskDone(ctx, instanceId); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("project_id") {
+		request := sqlserver.NewModifyDBInstanceProjectRequest()
+		projectId := int64(d.Get("project_id").(int))
+		request.InstanceIdSet = []*string{&instanceId}
+		request.ProjectId = &projectId
+
+		ratelimit.Check(request.GetAction())
+		if _, err := me.client.UseSqlserverClient().ModifyDBInstanceProject(request); err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return err
+		}
+	}
+
+	if d.HasChange("internet_service") || d.HasChange("public_access_enabled") {
+		enable := d.Get("internet_service").(bool) || d.Get("public_access_enabled").(bool)
+		if err := me.modifySqlserverInstanceExtranetAccess(ctx, instanceId, enable); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("ssl_enforcement") {
+		if err := me.modifySqlserverInstanceSSL(ctx, instanceId, d.Get("ssl_enforcement").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags") {
+		tagService := &TagService{client: me.client}
+		oldTags, newTags := d.GetChange("tags")
+		if err := tagService.ReplaceTags(ctx, "sqlserver", "instance", me.client.Region, instanceId, oldTags.(map[string]interface{}), newTags.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// modifySqlserverInstanceSSL turns mandatory SSL enforcement on the
+// instance's private VIP on or off, the toggle ssl_enforcement drives.
+func (me *SqlserverService) modifySqlserverInstanceSSL(ctx context.Context, instanceId string, enable bool) error {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewModifyDBInstanceSSLRequest()
+	sslEnable := int64(0)
+	if enable {
+		sslEnable = 1
+	}
+	request.InstanceId = &instanceId
+	request.SSLEnable = &sslEnable
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseSqlserverClient().ModifyDBInstanceSSL(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	return nil
+}
+
+// ApplySqlserverInstanceCreateSettings pushes the settings
+// CreateSqlserverBasicInstance/CreateSqlserverBasicInstanceFromTemplate
+// cannot provision through CreateBasicDBInstances itself: tags, extranet
+// access, and SSL enforcement. Without this, those would only ever be
+// applied starting from a later Update, since ModifySqlserverInstance only
+// acts on d.HasChange and a just-created resource's state already reflects
+// them as if they had taken effect.
+func (me *SqlserverService) ApplySqlserverInstanceCreateSettings(ctx context.Context, instanceId string, tags map[string]interface{}, extranetEnabled, sslEnabled bool) error {
+	if extranetEnabled {
+		if err := me.modifySqlserverInstanceExtranetAccess(ctx, instanceId, true); err != nil {
+			return err
+		}
+	}
+
+	if sslEnabled {
+		if err := me.modifySqlserverInstanceSSL(ctx, instanceId, true); err != nil {
+			return err
+		}
+	}
+
+	if len(tags) > 0 {
+		tagService := &TagService{client: me.client}
+		if err := tagService.ReplaceTags(ctx, "sqlserver", "instance", me.client.Region, instanceId, map[string]interface{}{}, tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// modifySqlserverInstanceExtranetAccess opens or closes the instance's
+// public VIP, the same toggle internet_service and public_access_enabled
+// both drive.
+func (me *SqlserverService) modifySqlserverInstanceExtranetAccess(ctx context.Context, instanceId string, enable bool) error {
+	logId := getLogId(ctx)
+
+	if enable {
+		request := sqlserver.NewOpenDBExtranetAccessRequest()
+		request.InstanceId = &instanceId
+
+		ratelimit.Check(request.GetAction())
+		if _, err := me.client.UseSqlserverClient().OpenDBExtranetAccess(request); err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return err
+		}
+		return nil
+	}
+
+	request := sqlserver.NewCloseDBExtranetAccessRequest()
+	request.InstanceId = &instanceId
+
+	ratelimit.Check(request.GetAction())
+	if _, err := me.client.UseSqlserverClient().CloseDBExtranetAccess(request); err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// waitSqlserverInstanceTaskDone polls until the instance is back in status 2
+// (running) after a spec change; any other status, transient or not, keeps
+// it waiting until readRetryTimeout.
+func (me *SqlserverService) waitSqlserverInstanceTaskDone(ctx context.Context, instanceId string) error {
+	return resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		instance, has, err := me.DescribeSqlserverInstanceById(ctx, instanceId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if !has {
+			return resource.NonRetryableError(fmt.Errorf("instance %s disappeared while waiting for its spec change to finish", instanceId))
+		}
+		if *instance.Status != SQLSERVER_STATUS_RUNNING {
+			return resource.RetryableError(fmt.Errorf("instance %s is in status %d, waiting for it to become running", instanceId, *instance.Status))
+		}
+		return nil
+	})
+}
+
+// DeleteSqlserverInstance terminates a POSTPAID_BY_HOUR SQL Server basic
+// instance. Prepaid instances would need to be isolated first, but this
+// resource only ever creates POSTPAID_BY_HOUR instances.
+func (me *SqlserverService) DeleteSqlserverInstance(ctx context.Context, instanceId string) error {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewTerminateDBInstanceRequest()
+	request.InstanceId = &instanceId
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().TerminateDBInstance(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return nil
+}
+
+// DescribeSqlserverInstancesByFilter returns one page of instances matching
+// filter along with the total count matching it (ignoring filter.Offset and
+// filter.Limit), so a caller paging through DescribeDBInstances can tell
+// when it has seen everything.
+func (me *SqlserverService) DescribeSqlserverInstancesByFilter(ctx context.Context, filter SqlserverInstanceFilter) (instances []*sqlserver.DBInstance, total int64, errRet error) {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewDescribeDBInstancesRequest()
+	if filter.InstanceId != "" {
+		instanceId := filter.InstanceId
+		request.InstanceIdSet = []*string{&instanceId}
+	}
+	if filter.ProjectId >= 0 {
+		projectId := int64(filter.ProjectId)
+		request.ProjectId = &projectId
+	}
+	if filter.VpcId != "" {
+		vpcId := filter.VpcId
+		request.VpcId = &vpcId
+	}
+	if filter.SubnetId != "" {
+		subnetId := filter.SubnetId
+		request.SubnetId = &subnetId
+	}
+	if filter.AvailabilityZone != "" {
+		zone := filter.AvailabilityZone
+		request.Zone = &zone
+	}
+	if filter.EngineVersion != "" {
+		engineVersion := filter.EngineVersion
+		request.DBVersion = &engineVersion
+	}
+	if filter.ChargeType != "" {
+		chargeType := filter.ChargeType
+		request.PayMode = &chargeType
+	}
+	if filter.Status >= 0 {
+		status := int64(filter.Status)
+		request.Status = []*int64{&status}
+	}
+
+	offset := uint64(filter.Offset)
+	limit := uint64(filter.Limit)
+	request.Offset = &offset
+	request.Limit = &limit
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().DescribeDBInstances(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return nil, 0, err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response.Response.TotalCount != nil {
+		total = *response.Response.TotalCount
+	}
+
+	return response.Response.DBInstances, total, nil
+}
+
+// BootstrapSqlserverBasicInstance runs userData followed by every statement
+// in sqlStatements against the instance, in order, over the same managed SQL
+// execution channel the console's query tool uses.
+func (me *SqlserverService) BootstrapSqlserverBasicInstance(ctx context.Context, instanceId, userData string, sqlStatements []string) error {
+	logId := getLogId(ctx)
+
+	statements := make([]string, 0, len(sqlStatements)+1)
+	if userData != "" {
+		statements = append(statements, userData)
+	}
+	statements = append(statements, sqlStatements...)
+
+	for _, stmt := range statements {
+		sql := stmt
+		request := sqlserver.NewExecuteDatabaseSQLRequest()
+		request.InstanceId = &instanceId
+		request.SQL = &sql
+
+		ratelimit.Check(request.GetAction())
+
+		response, err := me.client.UseSqlserverClient().ExecuteDatabaseSQL(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return err
+		}
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+	}
+
+	return nil
+}
+
+// SqlserverInstanceTemplate is the set of defaults a
+// tencentcloud_sqlserver_instance_template stores, and every field
+// tencentcloud_sqlserver_basic_instance_from_template can inherit from it.
+type SqlserverInstanceTemplate struct {
+	Name                string
+	EngineVersion       string
+	Memory              int
+	Cpu                 int
+	Storage             int
+	MachineType         string
+	ProjectId           int
+	SecurityGroups      []string
+	BackupTime          string
+	MaintenanceTimeSpan string
+	Tags                map[string]string
+	CreateTime          string
+}
+
+// CreateSqlserverInstanceTemplate creates an instance template and returns
+// its id. A template does not provision any SQL Server instance itself.
+func (me *SqlserverService) CreateSqlserverInstanceTemplate(ctx context.Context, d *schema.ResourceData) (string, error) {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewCreateInstanceTemplateRequest()
+	name := d.Get("name").(string)
+	request.TemplateName = &name
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		engineVersion := v.(string)
+		request.DBVersion = &engineVersion
+	}
+	if v, ok := d.GetOk("memory"); ok {
+		memory := uint64(v.(int))
+		request.Memory = &memory
+	}
+	if v, ok := d.GetOk("cpu"); ok {
+		cpu := int64(v.(int))
+		request.Cpu = &cpu
+	}
+	if v, ok := d.GetOk("storage"); ok {
+		storage := uint64(v.(int))
+		request.Storage = &storage
+	}
+	if v, ok := d.GetOk("machine_type"); ok {
+		machineType := v.(string)
+		request.MachineType = &machineType
+	}
+	if v, ok := d.GetOk("project_id"); ok {
+		projectId := int64(v.(int))
+		request.ProjectId = &projectId
+	}
+	for _, v := range d.Get("security_groups").([]interface{}) {
+		sgId := v.(string)
+		request.SecurityGroupList = append(request.SecurityGroupList, &sgId)
+	}
+	if v, ok := d.GetOk("backup_time"); ok {
+		backupTime := v.(string)
+		request.BackupTime = &backupTime
+	}
+	if v, ok := d.GetOk("maintenance_time_span"); ok {
+		maintenanceTimeSpan := v.(string)
+		request.MaintenanceTimeSpan = &maintenanceTimeSpan
+	}
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		tagKey := k
+		tagValue := v.(string)
+		request.Tags = append(request.Tags, &sqlserver.Tag{TagKey: &tagKey, TagValue: &tagValue})
+	}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().CreateInstanceTemplate(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return "", err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return *response.Response.TemplateId, nil
+}
+
+// DescribeSqlserverInstanceTemplateById returns the instance template with
+// the given id, has is false when it no longer exists.
+func (me *SqlserverService) DescribeSqlserverInstanceTemplateById(ctx context.Context, templateId string) (template *SqlserverInstanceTemplate, has bool, errRet error) {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewDescribeInstanceTemplatesRequest()
+	request.TemplateIdSet = []*string{&templateId}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().DescribeInstanceTemplates(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return nil, false, err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if len(response.Response.TemplateSet) == 0 {
+		return nil, false, nil
+	}
+
+	raw := response.Response.TemplateSet[0]
+	t := &SqlserverInstanceTemplate{}
+	if raw.TemplateName != nil {
+		t.Name = *raw.TemplateName
+	}
+	if raw.DBVersion != nil {
+		t.EngineVersion = *raw.DBVersion
+	}
+	if raw.Memory != nil {
+		t.Memory = int(*raw.Memory)
+	}
+	if raw.Cpu != nil {
+		t.Cpu = int(*raw.Cpu)
+	}
+	if raw.Storage != nil {
+		t.Storage = int(*raw.Storage)
+	}
+	if raw.MachineType != nil {
+		t.MachineType = *raw.MachineType
+	}
+	if raw.ProjectId != nil {
+		t.ProjectId = int(*raw.ProjectId)
+	}
+	for _, sg := range raw.SecurityGroupList {
+		if sg != nil {
+			t.SecurityGroups = append(t.SecurityGroups, *sg)
+		}
+	}
+	if raw.BackupTime != nil {
+		t.BackupTime = *raw.BackupTime
+	}
+	if raw.MaintenanceTimeSpan != nil {
+		t.MaintenanceTimeSpan = *raw.MaintenanceTimeSpan
+	}
+	if len(raw.Tags) > 0 {
+		t.Tags = make(map[string]string, len(raw.Tags))
+		for _, rawTag := range raw.Tags {
+			if rawTag.TagKey != nil && rawTag.TagValue != nil {
+				t.Tags[*rawTag.TagKey] = *rawTag.TagValue
+			}
+		}
+	}
+	if raw.CreateTime != nil {
+		t.CreateTime = *raw.CreateTime
+	}
+
+	return t, true, nil
+}
+
+// ModifySqlserverInstanceTemplate pushes every field that changed in d to
+// the template.
+func (me *SqlserverService) ModifySqlserverInstanceTemplate(ctx context.Context, d *schema.ResourceData) error {
+	logId := getLogId(ctx)
+	templateId := d.Id()
+
+	request := sqlserver.NewModifyInstanceTemplateRequest()
+	request.TemplateId = &templateId
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		request.TemplateName = &name
+	}
+	if d.HasChange("engine_version") {
+		engineVersion := d.Get("engine_version").(string)
+		request.DBVersion = &engineVersion
+	}
+	if d.HasChange("memory") {
+		memory := uint64(d.Get("memory").(int))
+		request.Memory = &memory
+	}
+	if d.HasChange("cpu") {
+		cpu := int64(d.Get("cpu").(int))
+		request.Cpu = &cpu
+	}
+	if d.HasChange("storage") {
+		storage := uint64(d.Get("storage").(int))
+		request.Storage = &storage
+	}
+	if d.HasChange("machine_type") {
+		machineType := d.Get("machine_type").(string)
+		request.MachineType = &machineType
+	}
+	if d.HasChange("project_id") {
+		projectId := int64(d.Get("project_id").(int))
+		request.ProjectId = &projectId
+	}
+	if d.HasChange("security_groups") {
+		for _, v := range d.Get("security_groups").([]interface{}) {
+			sgId := v.(string)
+			request.SecurityGroupList = append(request.SecurityGroupList, &sgId)
+		}
+	}
+	if d.HasChange("backup_time") {
+		backupTime := d.Get("backup_time").(string)
+		request.BackupTime = &backupTime
+	}
+	if d.HasChange("maintenance_time_span") {
+		maintenanceTimeSpan := d.Get("maintenance_time_span").(string)
+		request.MaintenanceTimeSpan = &maintenanceTimeSpan
+	}
+	if d.HasChange("tags") {
+		for k, v := range d.Get("tags").(map[string]interface{}) {
+			tagKey := k
+			tagValue := v.(string)
+			request.Tags = append(request.Tags, &sqlserver.Tag{TagKey: &tagKey, TagValue: &tagValue})
+		}
+	}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().ModifyInstanceTemplate(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return nil
+}
+
+// DeleteSqlserverInstanceTemplateById deletes an instance template. It does
+// not affect any instance previously stamped out from it.
+func (me *SqlserverService) DeleteSqlserverInstanceTemplateById(ctx context.Context, templateId string) error {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewDeleteInstanceTemplateRequest()
+	request.TemplateId = &templateId
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().DeleteInstanceTemplate(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return nil
+}
+
+// CreateSqlserverBasicInstanceFromTemplate stamps out a basic instance,
+// falling back to template for every field d leaves unset.
+func (me *SqlserverService) CreateSqlserverBasicInstanceFromTemplate(ctx context.Context, d *schema.ResourceData, template *SqlserverInstanceTemplate) (string, error) {
+	logId := getLogId(ctx)
+
+	request := sqlserver.NewCreateBasicDBInstancesRequest()
+	zone := d.Get("availability_zone").(string)
+	vpcId := d.Get("vpc_id").(string)
+	subnetId := d.Get("subnet_id").(string)
+	request.Zone = &zone
+	request.UniqVpcId = &vpcId
+	request.UniqSubnetId = &subnetId
+
+	machineType := template.MachineType
+	if v, ok := d.GetOk("machine_type"); ok {
+		machineType = v.(string)
+	}
+	request.MachineType = &machineType
+
+	projectId := int64(template.ProjectId)
+	if v, ok := d.GetOkExists("project_id"); ok {
+		projectId = int64(v.(int))
+	}
+	request.ProjectId = &projectId
+
+	memory := uint64(template.Memory)
+	if v, ok := d.GetOk("memory"); ok {
+		memory = uint64(v.(int))
+	}
+	request.Memory = &memory
+
+	storage := uint64(template.Storage)
+	if v, ok := d.GetOk("storage"); ok {
+		storage = uint64(v.(int))
+	}
+	request.Storage = &storage
+
+	engineVersion := template.EngineVersion
+	if v, ok := d.GetOk("engine_version"); ok {
+		engineVersion = v.(string)
+	}
+	request.DBVersion = &engineVersion
+
+	if cpu, ok := d.GetOk("cpu"); ok && cpu.(int) > 0 {
+		cpuValue := int64(cpu.(int))
+		request.Cpu = &cpuValue
+	} else if template.Cpu > 0 {
+		cpuValue := int64(template.Cpu)
+		request.Cpu = &cpuValue
+	}
+
+	securityGroups := template.SecurityGroups
+	if v, ok := d.GetOkExists("security_groups"); ok {
+		securityGroups = nil
+		for _, sg := range v.([]interface{}) {
+			securityGroups = append(securityGroups, sg.(string))
+		}
+	}
+	for _, sg := range securityGroups {
+		sgId := sg
+		request.SecurityGroupList = append(request.SecurityGroupList, &sgId)
+	}
+
+	goodsNum := int64(1)
+	request.GoodsNum = &goodsNum
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseSqlserverClient().CreateBasicDBInstances(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return "", err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if len(response.Response.DealNames) == 0 {
+		return "", fmt.Errorf("[CRITAL]%s CreateBasicDBInstances returned no deal name", logId)
+	}
+	dealName := *response.Response.DealNames[0]
+
+	var instanceId string
+	err = resource.Retry(readRetryTimeout, func() *resource.RetryError {
+		id, isReady, inErr := me.DescribeSqlserverInstanceIdByDealName(ctx, dealName)
+		if inErr != nil {
+			return resource.NonRetryableError(inErr)
+		}
+		if !isReady {
+			return resource.RetryableError(fmt.Errorf("deal %s has not produced an instance id yet", dealName))
+		}
+		instanceId = id
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		if err := me.renameSqlserverBasicInstance(ctx, instanceId, name.(string)); err != nil {
+			return instanceId, err
+		}
+	}
+
+	return instanceId, nil
+}
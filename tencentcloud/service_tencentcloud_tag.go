@@ -0,0 +1,140 @@
+package tencentcloud
+
+import (
+	"context"
+	"log"
+
+	tag "github.com/tencentcloudstack/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
+)
+
+// TagService wraps the tag v20180813 client every resource and data source
+// that reads or writes tags goes through.
+type TagService struct {
+	client *connectivity.TencentCloudClient
+}
+
+// DescribeResourceTagsByResourceIds batches DescribeResourceTagsByResourceIds
+// across every id in resourceIds and returns the tags found for each, keyed
+// by resource id. A resource with no tags is simply absent from the result
+// instead of mapping to an empty map.
+func (me *TagService) DescribeResourceTagsByResourceIds(ctx context.Context, serviceType, resourceType, region string, resourceIds []string) (map[string]map[string]string, error) {
+	logId := getLogId(ctx)
+
+	result := make(map[string]map[string]string)
+	if len(resourceIds) == 0 {
+		return result, nil
+	}
+
+	request := tag.NewDescribeResourceTagsByResourceIdsRequest()
+	request.ServiceType = &serviceType
+	request.ResourceType = &resourceType
+	request.ResourcePrefix = &resourceType
+	request.Region = &region
+	for _, id := range resourceIds {
+		resourceId := id
+		request.ResourceIds = append(request.ResourceIds, &resourceId)
+	}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseTagClient().DescribeResourceTagsByResourceIds(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return nil, err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	for _, row := range response.Response.Rows {
+		if row.ResourceId == nil {
+			continue
+		}
+		tags, ok := result[*row.ResourceId]
+		if !ok {
+			tags = make(map[string]string)
+			result[*row.ResourceId] = tags
+		}
+		if row.TagKey != nil && row.TagValue != nil {
+			tags[*row.TagKey] = *row.TagValue
+		}
+	}
+
+	return result, nil
+}
+
+// ReplaceTags brings resourceId's tags from oldTags to newTags: any key
+// present in oldTags but absent from newTags is deleted, every key in
+// newTags is created or updated. Keys untouched between the two maps are
+// left alone.
+func (me *TagService) ReplaceTags(ctx context.Context, serviceType, resourceType, region, resourceId string, oldTags, newTags map[string]interface{}) error {
+	for k := range oldTags {
+		if _, ok := newTags[k]; ok {
+			continue
+		}
+		if err := me.deleteResourceTag(ctx, serviceType, resourceType, region, resourceId, k); err != nil {
+			return err
+		}
+	}
+
+	for k, v := range newTags {
+		if err := me.modifyResourceTag(ctx, serviceType, resourceType, region, resourceId, k, v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (me *TagService) modifyResourceTag(ctx context.Context, serviceType, resourceType, region, resourceId, key, value string) error {
+	logId := getLogId(ctx)
+
+	request := tag.NewModifyResourceTagsRequest()
+	request.ServiceType = &serviceType
+	request.ResourceId = &resourceId
+	request.ResourcePrefix = &resourceType
+	request.Region = &region
+	request.ReplaceTags = []*tag.Tag{
+		{
+			TagKey:   &key,
+			TagValue: &value,
+		},
+	}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseTagClient().ModifyResourceTags(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return nil
+}
+
+func (me *TagService) deleteResourceTag(ctx context.Context, serviceType, resourceType, region, resourceId, key string) error {
+	logId := getLogId(ctx)
+
+	request := tag.NewModifyResourceTagsRequest()
+	request.ServiceType = &serviceType
+	request.ResourceId = &resourceId
+	request.ResourcePrefix = &resourceType
+	request.Region = &region
+	request.DeleteTags = []*tag.Tag{
+		{
+			TagKey: &key,
+		},
+	}
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseTagClient().ModifyResourceTags(request)
+	if err != nil {
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), err.Error())
+		return err
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	return nil
+}